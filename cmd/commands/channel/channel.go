@@ -7,11 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package channel
 
 import (
-	"fmt"
-	"os"
-	"os/signal"
-	"syscall"
-
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/logging"
 	"github.com/spf13/cobra"
 
 	"github.com/hyperledger/fabric-cli/cmd/common"
@@ -19,6 +15,8 @@ import (
 	"github.com/hyperledger/fabric-cli/pkg/fabric"
 )
 
+var logger = logging.NewLogger("fabric-cli/channel")
+
 // NewChannelCommand creates a new "fabric channel" command
 func NewChannelCommand(settings *environment.Settings) *cobra.Command {
 	cmd := &cobra.Command{
@@ -63,36 +61,40 @@ func (c *BaseCommand) Complete() error {
 		return err
 	}
 
-	go c.closeOnExit()
+	go c.closeOnContextDone()
 
 	return nil
 }
 
-func (c *BaseCommand) closeOnExit() {
-	sigs := make(chan os.Signal, 1)
-	done := make(chan bool, 1)
+// Close releases the resources acquired by Complete. Commands call this
+// from their cobra.Command's PostRunE so the SDK is closed on normal exit,
+// not only when the process is asked to shut down.
+func (c *BaseCommand) Close() error {
+	if c.Factory == nil {
+		return nil
+	}
 
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	sdk, err := c.Factory.SDK()
+	if err != nil {
+		return nil
+	}
 
-	go func() {
-		sig := <-sigs
-		fmt.Println(sig)
-		done <- true
-	}()
+	logger.Debug("closing SDK")
+	sdk.Close()
 
-	fmt.Println("awaiting signal...")
+	return nil
+}
 
-	<-done
+// closeOnContextDone closes the SDK when the command's context is
+// cancelled, i.e. when the process receives a shutdown signal. This is a
+// best-effort backstop for long-running commands; Close is still the
+// primary cleanup path for commands that return normally.
+func (c *BaseCommand) closeOnContextDone() {
+	<-c.Context().Done()
 
-	fmt.Println("... exiting")
+	logger.Debug("context cancelled, closing SDK")
 
-	if c.Factory != nil {
-		sdk, err := c.Factory.SDK()
-		if err != nil {
-			fmt.Println(err.Error())
-		} else {
-			fmt.Println("Closing SDK")
-			sdk.Close()
-		}
+	if err := c.Close(); err != nil {
+		logger.Debugf("error closing SDK: %s", err)
 	}
 }
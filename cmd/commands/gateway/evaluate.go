@@ -0,0 +1,93 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// EvaluateCommand implements the "fabric gateway evaluate" (aka "query")
+// command
+type EvaluateCommand struct {
+	BaseCommand
+
+	Channel   string
+	Chaincode string
+	Function  string
+	Args      []string
+}
+
+// NewEvaluateCommand creates a new "fabric gateway evaluate" command
+func NewEvaluateCommand(settings *environment.Settings) *cobra.Command {
+	c := &EvaluateCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:     "evaluate <chaincode-name> <function> [args...]",
+		Aliases: []string{"query"},
+		Short:   "Evaluate a transaction through the gateway without submitting it",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c.Chaincode = args[0]
+			c.Function = args[1]
+			c.Args = args[2:]
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			if err := c.Complete(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			return c.Close()
+		},
+	}
+
+	cmd.Flags().StringVarP(&c.Channel, "channel", "C", "", "the channel the chaincode is instantiated on")
+	addIdentityFlag(cmd, &c.Identity)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid
+func (c *EvaluateCommand) Validate() error {
+	if c.Channel == "" {
+		return errors.New("channel not specified")
+	}
+
+	return nil
+}
+
+// Run evaluates the transaction and prints its response payload
+func (c *EvaluateCommand) Run() error {
+	network, err := c.Gateway.GetNetwork(c.Channel)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get network")
+	}
+
+	contract := network.GetContract(c.Chaincode)
+
+	result, err := contract.EvaluateTransaction(c.Function, c.Args...)
+	if err != nil {
+		return errors.WithMessage(err, "failed to evaluate transaction")
+	}
+
+	fmt.Fprintln(c.Out(), string(result))
+
+	return nil
+}
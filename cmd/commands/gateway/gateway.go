@@ -0,0 +1,123 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package gateway implements the "fabric gateway" command tree. Unlike the
+// channel and lifecycle commands, which drive resmgmt/channel clients
+// against a full connection profile, the gateway commands consume a wallet
+// identity and let the fabric-sdk-go gateway handle discovery, endorsement
+// and commit-listening.
+package gateway
+
+import (
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/logging"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/common"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+	"github.com/hyperledger/fabric-cli/pkg/fabric"
+	fabricgateway "github.com/hyperledger/fabric-cli/pkg/fabric/gateway"
+)
+
+var logger = logging.NewLogger("fabric-cli/gateway")
+
+// NewGatewayCommand creates a new "fabric gateway" command
+func NewGatewayCommand(settings *environment.Settings) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway",
+		Short: "Submit and evaluate transactions through the gateway",
+	}
+
+	cmd.AddCommand(
+		NewSubmitCommand(settings),
+		NewEvaluateCommand(settings),
+		NewListenCommand(settings),
+	)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// BaseCommand implements the fields and helpers shared by the gateway
+// subcommands.
+type BaseCommand struct {
+	common.Command
+
+	Factory  fabric.Factory
+	Gateway  *fabricgateway.Gateway
+	Identity string
+}
+
+// Complete resolves the wallet identity to use (the --identity flag, or the
+// current context's user if unset) and opens a gateway connection with it.
+func (c *BaseCommand) Complete() error {
+	var err error
+
+	if c.Factory == nil {
+		c.Factory, err = fabric.NewFactory(c.Settings.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, err := c.Settings.Config.Current()
+	if err != nil {
+		return err
+	}
+
+	label := c.Identity
+	if label == "" {
+		label = ctx.User
+	}
+
+	store := environment.NewWalletStore(c.Settings.Home)
+
+	identity, err := store.Get(label)
+	if err != nil {
+		return err
+	}
+
+	c.Gateway, err = c.Factory.Gateway(identity)
+	if err != nil {
+		return err
+	}
+
+	go c.closeOnContextDone()
+
+	return nil
+}
+
+// Close releases the gateway connection acquired by Complete. Commands call
+// this from their cobra.Command's PostRunE so the connection is closed on
+// normal exit, not only when the process is asked to shut down.
+func (c *BaseCommand) Close() error {
+	if c.Gateway == nil {
+		return nil
+	}
+
+	logger.Debug("closing gateway")
+	c.Gateway.Close()
+
+	return nil
+}
+
+// closeOnContextDone closes the gateway connection when the command's
+// context is cancelled, i.e. when the process receives a shutdown signal.
+func (c *BaseCommand) closeOnContextDone() {
+	<-c.Context().Done()
+
+	logger.Debug("context cancelled, closing gateway")
+
+	if err := c.Close(); err != nil {
+		logger.Debugf("error closing gateway: %s", err)
+	}
+}
+
+// addIdentityFlag registers the --identity/-i flag shared by the gateway
+// subcommands.
+func addIdentityFlag(cmd *cobra.Command, identity *string) {
+	cmd.Flags().StringVarP(identity, "identity", "i", "", "the wallet identity to use (defaults to the current context's user)")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// ListenCommand implements the "fabric gateway listen" command, which
+// prints chaincode events as they are received.
+type ListenCommand struct {
+	BaseCommand
+
+	Channel   string
+	Chaincode string
+	EventName string
+}
+
+// NewListenCommand creates a new "fabric gateway listen" command
+func NewListenCommand(settings *environment.Settings) *cobra.Command {
+	c := &ListenCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:   "listen <chaincode-name> <event-name>",
+		Short: "Listen for a chaincode event",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c.Chaincode = args[0]
+			c.EventName = args[1]
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			if err := c.Complete(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			return c.Close()
+		},
+	}
+
+	cmd.Flags().StringVarP(&c.Channel, "channel", "C", "", "the channel the chaincode is instantiated on")
+	addIdentityFlag(cmd, &c.Identity)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid
+func (c *ListenCommand) Validate() error {
+	if c.Channel == "" {
+		return errors.New("channel not specified")
+	}
+
+	return nil
+}
+
+// Run registers for the given chaincode event and prints each occurrence as
+// it is received, until the command is interrupted.
+func (c *ListenCommand) Run() error {
+	network, err := c.Gateway.GetNetwork(c.Channel)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get network")
+	}
+
+	contract := network.GetContract(c.Chaincode)
+
+	reg, notifier, err := contract.RegisterEvent(c.EventName)
+	if err != nil {
+		return errors.WithMessage(err, "failed to register for event")
+	}
+	defer contract.Unregister(reg)
+
+	fmt.Fprintf(c.Out(), "listening for event '%s' on chaincode '%s'...\n", c.EventName, c.Chaincode)
+
+	for event := range notifier {
+		fmt.Fprintf(c.Out(), "received event '%s': %s\n", event.EventName, string(event.Payload))
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package gateway_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/commands/gateway"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+var _ = Describe("GatewayListenCommand", func() {
+	var (
+		cmd      *cobra.Command
+		settings *environment.Settings
+		out      *bytes.Buffer
+
+		args []string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		settings = &environment.Settings{
+			Home: environment.Home(os.TempDir()),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+
+		args = os.Args
+	})
+
+	JustBeforeEach(func() {
+		cmd = gateway.NewListenCommand(settings)
+	})
+
+	AfterEach(func() {
+		os.Args = args
+	})
+
+	It("should create a gateway listen command", func() {
+		Expect(cmd.Name()).To(Equal("listen"))
+		Expect(cmd.HasSubCommands()).To(BeFalse())
+	})
+
+	It("should provide a help prompt", func() {
+		os.Args = append(os.Args, "--help")
+
+		Expect(cmd.Execute()).Should(Succeed())
+		Expect(fmt.Sprint(out)).To(ContainSubstring("listen <chaincode-name> <event-name>"))
+	})
+})
+
+var _ = Describe("GatewayListenImplementation", func() {
+	var (
+		impl *gateway.ListenCommand
+		err  error
+	)
+
+	BeforeEach(func() {
+		impl = &gateway.ListenCommand{}
+	})
+
+	It("should not be nil", func() {
+		Expect(impl).ShouldNot(BeNil())
+	})
+
+	Describe("Validate", func() {
+		JustBeforeEach(func() {
+			err = impl.Validate()
+		})
+
+		It("should fail when channel is not set", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(Equal("channel not specified"))
+		})
+
+		Context("when channel is set", func() {
+			BeforeEach(func() {
+				impl.Channel = "mychannel"
+			})
+
+			It("should succeed", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,235 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// ApproveCommand implements the "fabric lifecycle approve" command
+type ApproveCommand struct {
+	BaseCommand
+
+	Name      string
+	Version   string
+	Sequence  string
+	PackageID string
+	Peers     []string
+
+	policyFlags
+
+	// EnvelopeSavePath, when set, causes Run to write the approve request to
+	// disk instead of broadcasting it to the orderer.
+	EnvelopeSavePath string
+	// EnvelopeLoadPath, when set, causes Run to load a previously saved
+	// approve request and broadcast it, using the current identity.
+	EnvelopeLoadPath string
+}
+
+// NewApproveCommand creates a new "fabric lifecycle approve" command
+func NewApproveCommand(settings *environment.Settings) *cobra.Command {
+	c := &ApproveCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:   "approve <chaincode-name> <version> <sequence>",
+		Short: "Approve a chaincode definition for this organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				c.Name = args[0]
+			}
+			if len(args) > 1 {
+				c.Version = args[1]
+			}
+			if len(args) > 2 {
+				c.Sequence = args[2]
+			}
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			if err := c.Complete(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			return c.Close()
+		},
+	}
+
+	cmd.Flags().StringVarP(&c.PackageID, "package-id", "", "", "the chaincode package ID returned by 'install'")
+	addPeerFlag(cmd, &c.Peers)
+	addEnvelopeFlags(cmd, &c.EnvelopeSavePath, &c.EnvelopeLoadPath)
+	addPolicyFlags(cmd, &c.policyFlags)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid. When an envelope
+// is being loaded via -L, the chaincode name/version/sequence are taken from
+// the envelope itself and are therefore optional.
+func (c *ApproveCommand) Validate() error {
+	if err := c.policyFlags.validate(); err != nil {
+		return err
+	}
+
+	if c.EnvelopeLoadPath != "" {
+		return nil
+	}
+
+	if c.Name == "" {
+		return errors.New("chaincode name not specified")
+	}
+
+	if c.Version == "" {
+		return errors.New("chaincode version not specified")
+	}
+
+	if c.Sequence == "" {
+		return errors.New("sequence not specified")
+	}
+
+	sequence, err := strconv.ParseInt(c.Sequence, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid sequence [%s]", c.Sequence)
+	}
+
+	if sequence <= 0 {
+		return errors.New("sequence must be greater than 0")
+	}
+
+	if len(c.Peers) == 0 {
+		return errors.New("at least one peer must be specified")
+	}
+
+	return nil
+}
+
+// Run executes the approve command, branching on the envelope flags the
+// same way CommitCommand.Run does.
+func (c *ApproveCommand) Run() error {
+	ctx, err := c.Settings.Config.Current()
+	if err != nil {
+		return err
+	}
+
+	if c.EnvelopeLoadPath != "" {
+		return c.runFromEnvelope()
+	}
+
+	sequence, err := strconv.ParseInt(c.Sequence, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	sigPolicy, err := c.policyFlags.signaturePolicy()
+	if err != nil {
+		return err
+	}
+
+	collections, err := c.policyFlags.collectionConfig()
+	if err != nil {
+		return err
+	}
+
+	req := resmgmt.LifecycleApproveCCRequest{
+		Name:                c.Name,
+		Version:             c.Version,
+		PackageID:           c.PackageID,
+		Sequence:            sequence,
+		SignaturePolicy:     sigPolicy,
+		ChannelConfigPolicy: c.ChannelConfigPolicy,
+		CollectionConfig:    collections,
+		InitRequired:        c.InitRequired,
+	}
+
+	if c.EnvelopeSavePath != "" {
+		env, err := buildEnvelope(&lifecycleUpdate{
+			Operation:           "approve",
+			Channel:             ctx.Channel,
+			Name:                c.Name,
+			Version:             c.Version,
+			PackageID:           c.PackageID,
+			Sequence:            sequence,
+			SignaturePolicy:     sigPolicy,
+			ChannelConfigPolicy: c.ChannelConfigPolicy,
+			CollectionConfig:    collections,
+			InitRequired:        c.InitRequired,
+			Peers:               c.Peers,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := writeEnvelope(c.EnvelopeSavePath, env); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(c.Out(), "envelope saved to '%s'\n", c.EnvelopeSavePath)
+
+		return nil
+	}
+
+	if _, err := c.ResourceManagement.LifecycleApproveCC(ctx.Channel, req, resmgmt.WithTargetEndpoints(c.Peers...)); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Out(), "successfully approved chaincode '%s'\n", c.Name)
+
+	return nil
+}
+
+// runFromEnvelope loads a previously saved approve request and broadcasts it
+// to the orderer, using the chaincode name, version, sequence and package ID
+// recovered from the envelope itself.
+func (c *ApproveCommand) runFromEnvelope() error {
+	env, err := readEnvelope(c.EnvelopeLoadPath)
+	if err != nil {
+		return err
+	}
+
+	update, err := lifecycleUpdateFromEnvelope(env, "approve")
+	if err != nil {
+		return err
+	}
+
+	peers := c.Peers
+	if len(peers) == 0 {
+		peers = update.Peers
+	}
+
+	req := resmgmt.LifecycleApproveCCRequest{
+		Name:                update.Name,
+		Version:             update.Version,
+		PackageID:           update.PackageID,
+		Sequence:            update.Sequence,
+		SignaturePolicy:     update.SignaturePolicy,
+		ChannelConfigPolicy: update.ChannelConfigPolicy,
+		CollectionConfig:    update.CollectionConfig,
+		InitRequired:        update.InitRequired,
+	}
+
+	if _, err := c.ResourceManagement.LifecycleApproveCC(update.Channel, req, resmgmt.WithTargetEndpoints(peers...)); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Out(), "successfully approved chaincode '%s'\n", update.Name)
+
+	return nil
+}
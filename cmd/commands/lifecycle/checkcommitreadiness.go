@@ -0,0 +1,197 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// CheckCommitReadinessCommand implements the
+// "fabric lifecycle checkcommitreadiness" command
+type CheckCommitReadinessCommand struct {
+	BaseCommand
+
+	Name     string
+	Version  string
+	Sequence string
+	Peers    []string
+	Output   string
+
+	policyFlags
+}
+
+// NewCheckCommitReadinessCommand creates a new
+// "fabric lifecycle checkcommitreadiness" command
+func NewCheckCommitReadinessCommand(settings *environment.Settings) *cobra.Command {
+	c := &CheckCommitReadinessCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:     "checkcommitreadiness <chaincode-name> <version> <sequence>",
+		Aliases: []string{"checkcommitparams"},
+		Short:   "Check whether a chaincode definition is ready to be committed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				c.Name = args[0]
+			}
+			if len(args) > 1 {
+				c.Version = args[1]
+			}
+			if len(args) > 2 {
+				c.Sequence = args[2]
+			}
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			if err := c.Complete(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			return c.Close()
+		},
+	}
+
+	addPeerFlag(cmd, &c.Peers)
+	addPolicyFlags(cmd, &c.policyFlags)
+	cmd.Flags().StringVarP(&c.Output, "output", "o", "table", "the output format: 'table' or 'json'")
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid
+func (c *CheckCommitReadinessCommand) Validate() error {
+	if err := c.policyFlags.validate(); err != nil {
+		return err
+	}
+
+	if c.Name == "" {
+		return errors.New("chaincode name not specified")
+	}
+
+	if c.Version == "" {
+		return errors.New("chaincode version not specified")
+	}
+
+	if c.Sequence == "" {
+		return errors.New("sequence not specified")
+	}
+
+	sequence, err := strconv.ParseInt(c.Sequence, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid sequence [%s]", c.Sequence)
+	}
+
+	if sequence <= 0 {
+		return errors.New("sequence must be greater than 0")
+	}
+
+	if len(c.Peers) == 0 {
+		return errors.New("at least one peer must be specified")
+	}
+
+	if c.Output != "table" && c.Output != "json" {
+		return errors.Errorf("invalid output format [%s]", c.Output)
+	}
+
+	return nil
+}
+
+// Run queries the commit readiness of the chaincode definition and renders
+// the per-organization approval status.
+func (c *CheckCommitReadinessCommand) Run() error {
+	ctx, err := c.Settings.Config.Current()
+	if err != nil {
+		return err
+	}
+
+	sequence, err := strconv.ParseInt(c.Sequence, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	sigPolicy, err := c.policyFlags.signaturePolicy()
+	if err != nil {
+		return err
+	}
+
+	collections, err := c.policyFlags.collectionConfig()
+	if err != nil {
+		return err
+	}
+
+	req := resmgmt.LifecycleCheckCCCommitReadinessRequest{
+		Name:                c.Name,
+		Version:             c.Version,
+		Sequence:            sequence,
+		SignaturePolicy:     sigPolicy,
+		ChannelConfigPolicy: c.ChannelConfigPolicy,
+		CollectionConfig:    collections,
+		InitRequired:        c.InitRequired,
+	}
+
+	resp, err := c.ResourceManagement.LifecycleCheckCCCommitReadiness(ctx.Channel, req, resmgmt.WithTargetEndpoints(c.Peers...))
+	if err != nil {
+		return err
+	}
+
+	if c.Output == "json" {
+		return c.printJSON(resp.Approvals)
+	}
+
+	return c.printTable(resp.Approvals)
+}
+
+func (c *CheckCommitReadinessCommand) printTable(approvals map[string]bool) error {
+	w := tabwriter.NewWriter(c.Out(), 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "MSP ID\tAPPROVED")
+
+	for _, mspID := range sortedMSPIDs(approvals) {
+		fmt.Fprintf(w, "%s\t%t\n", mspID, approvals[mspID])
+	}
+
+	return w.Flush()
+}
+
+func (c *CheckCommitReadinessCommand) printJSON(approvals map[string]bool) error {
+	b, err := json.MarshalIndent(approvals, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.Out(), string(b))
+
+	return nil
+}
+
+func sortedMSPIDs(approvals map[string]bool) []string {
+	mspIDs := make([]string, 0, len(approvals))
+	for mspID := range approvals {
+		mspIDs = append(mspIDs, mspID)
+	}
+
+	sort.Strings(mspIDs)
+
+	return mspIDs
+}
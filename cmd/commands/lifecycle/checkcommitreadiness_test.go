@@ -0,0 +1,234 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/commands/lifecycle"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+	"github.com/hyperledger/fabric-cli/pkg/fabric/mocks"
+)
+
+var _ = Describe("LifecycleCheckCommitReadinessCommand", func() {
+	var (
+		cmd      *cobra.Command
+		settings *environment.Settings
+		out      *bytes.Buffer
+
+		args []string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		settings = &environment.Settings{
+			Home: environment.Home(os.TempDir()),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+
+		args = os.Args
+	})
+
+	JustBeforeEach(func() {
+		cmd = lifecycle.NewCheckCommitReadinessCommand(settings)
+	})
+
+	AfterEach(func() {
+		os.Args = args
+	})
+
+	It("should create a lifecycle checkcommitreadiness command", func() {
+		Expect(cmd.Name()).To(Equal("checkcommitreadiness"))
+		Expect(cmd.HasSubCommands()).To(BeFalse())
+	})
+
+	It("should provide a help prompt", func() {
+		os.Args = append(os.Args, "--help")
+
+		Expect(cmd.Execute()).Should(Succeed())
+		Expect(fmt.Sprint(out)).To(ContainSubstring("checkcommitreadiness <chaincode-name> <version> <sequence>"))
+	})
+})
+
+var _ = Describe("LifecycleCheckCommitReadinessImplementation", func() {
+	var (
+		impl     *lifecycle.CheckCommitReadinessCommand
+		err      error
+		out      *bytes.Buffer
+		settings *environment.Settings
+		factory  *mocks.Factory
+		client   *mocks.ResourceManagement
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		settings = &environment.Settings{
+			Home: environment.Home(os.TempDir()),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+
+		factory = &mocks.Factory{}
+		client = &mocks.ResourceManagement{}
+
+		impl = &lifecycle.CheckCommitReadinessCommand{}
+		impl.Settings = settings
+		impl.Factory = factory
+		impl.Output = "table"
+	})
+
+	It("should not be nil", func() {
+		Expect(impl).ShouldNot(BeNil())
+	})
+
+	Describe("Validate", func() {
+		JustBeforeEach(func() {
+			err = impl.Validate()
+		})
+
+		It("should fail when name is not set", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(Equal("chaincode name not specified"))
+		})
+
+		Context("when both signature policy and channel config policy are set", func() {
+			BeforeEach(func() {
+				impl.Name = "mycc"
+				impl.Version = "0.0.0"
+				impl.Sequence = "1"
+				impl.Peers = []string{"peer1"}
+				impl.SignaturePolicy = "AND('Org1MSP.peer')"
+				impl.ChannelConfigPolicy = "/Channel/Application/Endorsement"
+			})
+
+			It("should fail with mutually exclusive policy flags", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(Equal("only one of --signature-policy or --channel-config-policy may be specified"))
+			})
+		})
+
+		Context("when at least one peer is not specified", func() {
+			BeforeEach(func() {
+				impl.Name = "mycc"
+				impl.Version = "0.0.0"
+				impl.Sequence = "1"
+			})
+
+			It("should fail with at least one peer is not specified", func() {
+				Expect(err.Error()).To(Equal("at least one peer must be specified"))
+			})
+		})
+
+		Context("when output format is invalid", func() {
+			BeforeEach(func() {
+				impl.Name = "mycc"
+				impl.Version = "0.0.0"
+				impl.Sequence = "1"
+				impl.Peers = []string{"peer1"}
+				impl.Output = "xml"
+			})
+
+			It("should fail with invalid output format", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("invalid output format"))
+			})
+		})
+
+		Context("when all arguments are set", func() {
+			BeforeEach(func() {
+				impl.Name = "mycc"
+				impl.Version = "0.0.0"
+				impl.Sequence = "1"
+				impl.Peers = []string{"peer1"}
+			})
+
+			It("should succeed with all arguments", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		BeforeEach(func() {
+			impl.Name = "mycc"
+			impl.Version = "0.0.0"
+			impl.Sequence = "1"
+			impl.ResourceManagement = client
+		})
+
+		JustBeforeEach(func() {
+			err = impl.Run()
+		})
+
+		It("should fail without a current context", func() {
+			Expect(err).NotTo(BeNil())
+		})
+
+		Context("when resmgmt client succeeds", func() {
+			BeforeEach(func() {
+				settings.Config = &environment.Config{
+					Contexts: map[string]*environment.Context{
+						"foo": {},
+					},
+					CurrentContext: "foo",
+				}
+
+				client.LifecycleCheckCCCommitReadinessReturns(resmgmt.LifecycleCheckCCCommitReadinessResponse{
+					Approvals: map[string]bool{"Org1MSP": true, "Org2MSP": false},
+				}, nil)
+			})
+
+			It("should print the approval status for each org", func() {
+				Expect(err).To(BeNil())
+				Expect(fmt.Sprint(out)).To(ContainSubstring("Org1MSP"))
+				Expect(fmt.Sprint(out)).To(ContainSubstring("Org2MSP"))
+			})
+
+			Context("with json output", func() {
+				BeforeEach(func() {
+					impl.Output = "json"
+				})
+
+				It("should print the approval status as json", func() {
+					Expect(err).To(BeNil())
+					Expect(fmt.Sprint(out)).To(ContainSubstring(`"Org1MSP": true`))
+				})
+			})
+		})
+
+		Context("when resmgmt client fails", func() {
+			BeforeEach(func() {
+				settings.Config = &environment.Config{
+					Contexts: map[string]*environment.Context{
+						"foo": {},
+					},
+					CurrentContext: "foo",
+				}
+
+				client.LifecycleCheckCCCommitReadinessReturns(resmgmt.LifecycleCheckCCCommitReadinessResponse{}, errors.New("readiness error"))
+			})
+
+			It("should fail to check commit readiness", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("readiness error"))
+			})
+		})
+	})
+})
@@ -0,0 +1,98 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/hyperledger/fabric-config/protolator"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/common/policydsl"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// addPeerFlag registers the --peer/-p flag shared by approve, commit and
+// checkcommitreadiness.
+func addPeerFlag(cmd *cobra.Command, peers *[]string) {
+	cmd.Flags().StringSliceVarP(peers, "peer", "p", nil, "the peers to endorse the lifecycle operation on")
+}
+
+// addEnvelopeFlags registers the -S/-L deferred-submission flag pair shared
+// by approve and commit: -S saves the request to a file instead of
+// submitting it, and -L loads a previously saved request and submits it.
+func addEnvelopeFlags(cmd *cobra.Command, savePath, loadPath *string) {
+	cmd.Flags().StringVarP(savePath, "envelope-save-path", "S", "", "write the request to this path instead of submitting it")
+	cmd.Flags().StringVarP(loadPath, "envelope-load-path", "L", "", "load and submit a previously saved request")
+}
+
+// policyFlags holds the endorsement policy/collection flags shared by
+// approve, commit and checkcommitreadiness.
+type policyFlags struct {
+	SignaturePolicy     string
+	ChannelConfigPolicy string
+	CollectionsConfig   string
+	InitRequired        bool
+}
+
+// addPolicyFlags registers the --signature-policy/--channel-config-policy
+// (mutually exclusive), --collections-config and --init-required flags
+// shared by approve, commit and checkcommitreadiness.
+func addPolicyFlags(cmd *cobra.Command, flags *policyFlags) {
+	cmd.Flags().StringVar(&flags.SignaturePolicy, "signature-policy", "", "an inline endorsement policy expression, e.g. \"AND('Org1MSP.peer','Org2MSP.peer')\"")
+	cmd.Flags().StringVar(&flags.ChannelConfigPolicy, "channel-config-policy", "", "a reference to a channel config policy to use as the endorsement policy, e.g. /Channel/Application/Endorsement")
+	cmd.Flags().StringVar(&flags.CollectionsConfig, "collections-config", "", "path to a JSON file defining the private data collection configuration")
+	cmd.Flags().BoolVar(&flags.InitRequired, "init-required", false, "whether the chaincode requires an Init invocation before use")
+}
+
+// validate ensures the endorsement policy flags are mutually exclusive.
+func (f *policyFlags) validate() error {
+	if f.SignaturePolicy != "" && f.ChannelConfigPolicy != "" {
+		return errors.New("only one of --signature-policy or --channel-config-policy may be specified")
+	}
+
+	return nil
+}
+
+// signaturePolicy parses the --signature-policy flag, if set, into a
+// signature policy envelope. cauthdsl.FromString was deprecated in favour of
+// policydsl.FromString in Fabric v2, so this uses the latter to avoid
+// depending on a removed symbol.
+func (f *policyFlags) signaturePolicy() (*common.SignaturePolicyEnvelope, error) {
+	if f.SignaturePolicy == "" {
+		return nil, nil
+	}
+
+	policy, err := policydsl.FromString(f.SignaturePolicy)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid signature policy")
+	}
+
+	return policy, nil
+}
+
+// collectionConfig parses the --collections-config flag, if set, into the
+// collection configurations accepted by resmgmt.
+func (f *policyFlags) collectionConfig() ([]*peer.CollectionConfig, error) {
+	if f.CollectionsConfig == "" {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadFile(f.CollectionsConfig)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to read collections config")
+	}
+
+	configPkg := &peer.CollectionConfigPackage{}
+	if err := protolator.DeepUnmarshalJSON(bytes.NewReader(b), configPkg); err != nil {
+		return nil, errors.WithMessage(err, "failed to parse collections config")
+	}
+
+	return configPkg.Config, nil
+}
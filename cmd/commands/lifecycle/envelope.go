@@ -0,0 +1,103 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+)
+
+// lifecycleUpdate carries the fields of a commit or approve request across a
+// save (-S) / load (-L) round trip, so that the chaincode name, version and
+// sequence don't need to be re-entered on the command line when the request
+// is finally submitted. Unlike a channel config update, a chaincode
+// commit/approve transaction is built and signed by a single identity, so
+// the envelope carries no signatures of its own: whichever identity loads
+// it via -L is the one that submits it.
+type lifecycleUpdate struct {
+	Operation           string                          `json:"operation"`
+	Channel             string                          `json:"channel"`
+	Name                string                          `json:"name"`
+	Version             string                          `json:"version"`
+	Sequence            int64                           `json:"sequence"`
+	PackageID           string                          `json:"packageId,omitempty"`
+	InitRequired        bool                            `json:"initRequired,omitempty"`
+	ChannelConfigPolicy string                          `json:"channelConfigPolicy,omitempty"`
+	SignaturePolicy     *common.SignaturePolicyEnvelope `json:"signaturePolicy,omitempty"`
+	CollectionConfig    []*peer.CollectionConfig        `json:"collectionConfig,omitempty"`
+	Peers               []string                        `json:"peers,omitempty"`
+}
+
+// buildEnvelope wraps a lifecycle update in a common.Envelope so that it can
+// be written to disk by -S and loaded again later by -L.
+func buildEnvelope(update *lifecycleUpdate) (*common.Envelope, error) {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal lifecycle update")
+	}
+
+	payloadBytes, err := proto.Marshal(&common.Payload{Data: data})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal envelope payload")
+	}
+
+	return &common.Envelope{Payload: payloadBytes}, nil
+}
+
+// lifecycleUpdateFromEnvelope recovers the lifecycle update carried by a
+// previously built envelope. It fails if the envelope was built for a
+// different operation, e.g. loading a commit envelope via "approve -L".
+func lifecycleUpdateFromEnvelope(env *common.Envelope, operation string) (*lifecycleUpdate, error) {
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(env.Payload, payload); err != nil {
+		return nil, errors.WithMessage(err, "failed to unmarshal envelope payload")
+	}
+
+	update := &lifecycleUpdate{}
+	if err := json.Unmarshal(payload.Data, update); err != nil {
+		return nil, errors.WithMessage(err, "failed to unmarshal lifecycle update")
+	}
+
+	if update.Operation != operation {
+		return nil, errors.Errorf("envelope was built for a '%s' operation, not '%s'", update.Operation, operation)
+	}
+
+	return update, nil
+}
+
+func writeEnvelope(path string, env *common.Envelope) error {
+	b, err := proto.Marshal(env)
+	if err != nil {
+		return errors.WithMessage(err, "failed to marshal envelope")
+	}
+
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		return errors.WithMessage(err, "failed to write envelope")
+	}
+
+	return nil
+}
+
+func readEnvelope(path string) (*common.Envelope, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to read envelope")
+	}
+
+	env := &common.Envelope{}
+	if err := proto.Unmarshal(b, env); err != nil {
+		return nil, errors.WithMessage(err, "failed to unmarshal envelope")
+	}
+
+	return env, nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package lifecycle implements the "fabric lifecycle" command tree, which
+// drives the Fabric chaincode lifecycle (approve/commit) introduced in
+// Fabric v2.0.
+package lifecycle
+
+import (
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/logging"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/common"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+	"github.com/hyperledger/fabric-cli/pkg/fabric"
+)
+
+var logger = logging.NewLogger("fabric-cli/lifecycle")
+
+// NewLifecycleCommand creates a new "fabric lifecycle" command
+func NewLifecycleCommand(settings *environment.Settings) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lifecycle",
+		Short: "Manage chaincode lifecycle",
+	}
+
+	cmd.AddCommand(
+		NewApproveCommand(settings),
+		NewCommitCommand(settings),
+		NewCheckCommitReadinessCommand(settings),
+	)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// BaseCommand implements the fields and helpers shared by the lifecycle
+// subcommands.
+type BaseCommand struct {
+	common.Command
+
+	Factory            fabric.Factory
+	ResourceManagement fabric.ResourceManagement
+}
+
+// Complete initializes the clients needed for Run
+func (c *BaseCommand) Complete() error {
+	var err error
+
+	if c.Factory == nil {
+		c.Factory, err = fabric.NewFactory(c.Settings.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.ResourceManagement, err = c.Factory.ResourceManagement()
+	if err != nil {
+		return err
+	}
+
+	go c.closeOnContextDone()
+
+	return nil
+}
+
+// Close releases the resources acquired by Complete. Commands call this
+// from their cobra.Command's PostRunE so the SDK is closed on normal exit,
+// not only when the process is asked to shut down.
+func (c *BaseCommand) Close() error {
+	if c.Factory == nil {
+		return nil
+	}
+
+	sdk, err := c.Factory.SDK()
+	if err != nil {
+		return nil
+	}
+
+	logger.Debug("closing SDK")
+	sdk.Close()
+
+	return nil
+}
+
+// closeOnContextDone closes the SDK when the command's context is
+// cancelled, i.e. when the process receives a shutdown signal.
+func (c *BaseCommand) closeOnContextDone() {
+	<-c.Context().Done()
+
+	logger.Debug("context cancelled, closing SDK")
+
+	if err := c.Close(); err != nil {
+		logger.Debugf("error closing SDK: %s", err)
+	}
+}
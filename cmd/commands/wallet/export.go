@@ -0,0 +1,79 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/common"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// ExportCommand implements the "fabric wallet export" command
+type ExportCommand struct {
+	common.Command
+
+	Label string
+}
+
+// NewExportCommand creates a new "fabric wallet export" command
+func NewExportCommand(settings *environment.Settings) *cobra.Command {
+	c := &ExportCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:   "export <label>",
+		Short: "Print an identity's certificate and key as JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				c.Label = args[0]
+			}
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+	}
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid
+func (c *ExportCommand) Validate() error {
+	if c.Label == "" {
+		return errors.New("identity label not specified")
+	}
+
+	return nil
+}
+
+// Run prints the identity stored under the given label as JSON
+func (c *ExportCommand) Run() error {
+	store := environment.NewWalletStore(c.Settings.Home)
+
+	identity, err := store.Get(c.Label)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(identity, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.Out(), string(b))
+
+	return nil
+}
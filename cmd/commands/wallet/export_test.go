@@ -0,0 +1,145 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/commands/wallet"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+var _ = Describe("WalletExportCommand", func() {
+	var (
+		cmd      *cobra.Command
+		settings *environment.Settings
+		out      *bytes.Buffer
+
+		args []string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		settings = &environment.Settings{
+			Home: environment.Home(os.TempDir()),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+
+		args = os.Args
+	})
+
+	JustBeforeEach(func() {
+		cmd = wallet.NewExportCommand(settings)
+	})
+
+	AfterEach(func() {
+		os.Args = args
+	})
+
+	It("should create a wallet export command", func() {
+		Expect(cmd.Name()).To(Equal("export"))
+		Expect(cmd.HasSubCommands()).To(BeFalse())
+	})
+
+	It("should provide a help prompt", func() {
+		os.Args = append(os.Args, "--help")
+
+		Expect(cmd.Execute()).Should(Succeed())
+		Expect(fmt.Sprint(out)).To(ContainSubstring("export <label>"))
+	})
+})
+
+var _ = Describe("WalletExportImplementation", func() {
+	var (
+		impl *wallet.ExportCommand
+		err  error
+		out  *bytes.Buffer
+		home string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		var homeErr error
+		home, homeErr = ioutil.TempDir("", "fabric-cli-wallet-export")
+		Expect(homeErr).To(BeNil())
+
+		impl = &wallet.ExportCommand{}
+		impl.Settings = &environment.Settings{
+			Home: environment.Home(home),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(home)
+	})
+
+	Describe("Validate", func() {
+		JustBeforeEach(func() {
+			err = impl.Validate()
+		})
+
+		It("should fail when label is not set", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(Equal("identity label not specified"))
+		})
+
+		Context("when label is set", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+			})
+
+			It("should succeed", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		JustBeforeEach(func() {
+			err = impl.Run()
+		})
+
+		Context("when the identity exists", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+
+				store := environment.NewWalletStore(environment.Home(home))
+				Expect(store.Put("admin", &environment.X509Identity{MSPID: "Org1MSP", Certificate: "CERT", Key: "KEY"})).To(Succeed())
+			})
+
+			It("should print the identity as json", func() {
+				Expect(err).To(BeNil())
+				Expect(fmt.Sprint(out)).To(ContainSubstring(`"mspId": "Org1MSP"`))
+			})
+		})
+
+		Context("when the identity does not exist", func() {
+			BeforeEach(func() {
+				impl.Label = "missing"
+			})
+
+			It("should fail to find the identity", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("not found in wallet"))
+			})
+		})
+	})
+})
@@ -0,0 +1,108 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/common"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// ImportCommand implements the "fabric wallet import" command
+type ImportCommand struct {
+	common.Command
+
+	Label    string
+	MSPID    string
+	CertPath string
+	KeyPath  string
+}
+
+// NewImportCommand creates a new "fabric wallet import" command
+func NewImportCommand(settings *environment.Settings) *cobra.Command {
+	c := &ImportCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:   "import <label>",
+		Short: "Import an X.509 identity into the wallet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				c.Label = args[0]
+			}
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&c.MSPID, "mspid", "", "the MSP ID the identity belongs to")
+	cmd.Flags().StringVar(&c.CertPath, "cert", "", "path to the identity's signing certificate")
+	cmd.Flags().StringVar(&c.KeyPath, "key", "", "path to the identity's private key")
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid
+func (c *ImportCommand) Validate() error {
+	if c.Label == "" {
+		return errors.New("identity label not specified")
+	}
+
+	if c.MSPID == "" {
+		return errors.New("mspid not specified")
+	}
+
+	if c.CertPath == "" {
+		return errors.New("cert not specified")
+	}
+
+	if c.KeyPath == "" {
+		return errors.New("key not specified")
+	}
+
+	return nil
+}
+
+// Run reads the certificate and key and stores them in the wallet under the
+// given label.
+func (c *ImportCommand) Run() error {
+	cert, err := ioutil.ReadFile(c.CertPath)
+	if err != nil {
+		return errors.WithMessage(err, "failed to read cert")
+	}
+
+	key, err := ioutil.ReadFile(c.KeyPath)
+	if err != nil {
+		return errors.WithMessage(err, "failed to read key")
+	}
+
+	identity := &environment.X509Identity{
+		MSPID:       c.MSPID,
+		Certificate: string(cert),
+		Key:         string(key),
+	}
+
+	store := environment.NewWalletStore(c.Settings.Home)
+	if err := store.Put(c.Label, identity); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Out(), "identity '%s' imported\n", c.Label)
+
+	return nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/commands/wallet"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+var _ = Describe("WalletImportCommand", func() {
+	var (
+		cmd      *cobra.Command
+		settings *environment.Settings
+		out      *bytes.Buffer
+
+		args []string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		settings = &environment.Settings{
+			Home: environment.Home(os.TempDir()),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+
+		args = os.Args
+	})
+
+	JustBeforeEach(func() {
+		cmd = wallet.NewImportCommand(settings)
+	})
+
+	AfterEach(func() {
+		os.Args = args
+	})
+
+	It("should create a wallet import command", func() {
+		Expect(cmd.Name()).To(Equal("import"))
+		Expect(cmd.HasSubCommands()).To(BeFalse())
+	})
+
+	It("should provide a help prompt", func() {
+		os.Args = append(os.Args, "--help")
+
+		Expect(cmd.Execute()).Should(Succeed())
+		Expect(fmt.Sprint(out)).To(ContainSubstring("import <label>"))
+	})
+})
+
+var _ = Describe("WalletImportImplementation", func() {
+	var (
+		impl    *wallet.ImportCommand
+		err     error
+		out     *bytes.Buffer
+		home    string
+		homeErr error
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+		home, homeErr = ioutil.TempDir("", "fabric-cli-wallet-import")
+		Expect(homeErr).To(BeNil())
+
+		impl = &wallet.ImportCommand{}
+		impl.Settings = &environment.Settings{
+			Home: environment.Home(home),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(home)
+	})
+
+	It("should not be nil", func() {
+		Expect(impl).ShouldNot(BeNil())
+	})
+
+	Describe("Validate", func() {
+		JustBeforeEach(func() {
+			err = impl.Validate()
+		})
+
+		It("should fail when label is not set", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(Equal("identity label not specified"))
+		})
+
+		Context("when mspid is not set", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+			})
+
+			It("should fail without mspid", func() {
+				Expect(err.Error()).To(Equal("mspid not specified"))
+			})
+		})
+
+		Context("when cert is not set", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+				impl.MSPID = "Org1MSP"
+			})
+
+			It("should fail without cert", func() {
+				Expect(err.Error()).To(Equal("cert not specified"))
+			})
+		})
+
+		Context("when key is not set", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+				impl.MSPID = "Org1MSP"
+				impl.CertPath = "cert.pem"
+			})
+
+			It("should fail without key", func() {
+				Expect(err.Error()).To(Equal("key not specified"))
+			})
+		})
+
+		Context("when all arguments are set", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+				impl.MSPID = "Org1MSP"
+				impl.CertPath = "cert.pem"
+				impl.KeyPath = "key.pem"
+			})
+
+			It("should succeed", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		JustBeforeEach(func() {
+			err = impl.Run()
+		})
+
+		Context("when the cert and key exist", func() {
+			BeforeEach(func() {
+				certPath := filepath.Join(home, "cert.pem")
+				keyPath := filepath.Join(home, "key.pem")
+
+				Expect(ioutil.WriteFile(certPath, []byte("CERT"), 0600)).To(Succeed())
+				Expect(ioutil.WriteFile(keyPath, []byte("KEY"), 0600)).To(Succeed())
+
+				impl.Label = "admin"
+				impl.MSPID = "Org1MSP"
+				impl.CertPath = certPath
+				impl.KeyPath = keyPath
+			})
+
+			It("should import the identity", func() {
+				Expect(err).To(BeNil())
+				Expect(fmt.Sprint(out)).To(Equal("identity 'admin' imported\n"))
+			})
+		})
+
+		Context("when the cert does not exist", func() {
+			BeforeEach(func() {
+				impl.Label = "admin"
+				impl.MSPID = "Org1MSP"
+				impl.CertPath = filepath.Join(home, "missing.pem")
+				impl.KeyPath = filepath.Join(home, "missing.pem")
+			})
+
+			It("should fail to read the cert", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("failed to read cert"))
+			})
+		})
+	})
+})
@@ -0,0 +1,60 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/common"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// ListCommand implements the "fabric wallet list" command
+type ListCommand struct {
+	common.Command
+}
+
+// NewListCommand creates a new "fabric wallet list" command
+func NewListCommand(settings *environment.Settings) *cobra.Command {
+	c := &ListCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the identities in the wallet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.Run()
+		},
+	}
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Run prints the labels of every identity currently in the wallet
+func (c *ListCommand) Run() error {
+	store := environment.NewWalletStore(c.Settings.Home)
+
+	labels, err := store.List()
+	if err != nil {
+		return err
+	}
+
+	if len(labels) == 0 {
+		fmt.Fprintln(c.Out(), "no identities found")
+		return nil
+	}
+
+	for _, label := range labels {
+		fmt.Fprintln(c.Out(), label)
+	}
+
+	return nil
+}
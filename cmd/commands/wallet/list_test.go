@@ -0,0 +1,111 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/commands/wallet"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+var _ = Describe("WalletListCommand", func() {
+	var (
+		cmd      *cobra.Command
+		settings *environment.Settings
+		out      *bytes.Buffer
+
+		args []string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		settings = &environment.Settings{
+			Home: environment.Home(os.TempDir()),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+
+		args = os.Args
+	})
+
+	JustBeforeEach(func() {
+		cmd = wallet.NewListCommand(settings)
+	})
+
+	AfterEach(func() {
+		os.Args = args
+	})
+
+	It("should create a wallet list command", func() {
+		Expect(cmd.Name()).To(Equal("list"))
+		Expect(cmd.HasSubCommands()).To(BeFalse())
+	})
+})
+
+var _ = Describe("WalletListImplementation", func() {
+	var (
+		impl *wallet.ListCommand
+		err  error
+		out  *bytes.Buffer
+		home string
+	)
+
+	BeforeEach(func() {
+		out = new(bytes.Buffer)
+
+		var homeErr error
+		home, homeErr = ioutil.TempDir("", "fabric-cli-wallet-list")
+		Expect(homeErr).To(BeNil())
+
+		impl = &wallet.ListCommand{}
+		impl.Settings = &environment.Settings{
+			Home: environment.Home(home),
+			Streams: environment.Streams{
+				Out: out,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(home)
+	})
+
+	JustBeforeEach(func() {
+		err = impl.Run()
+	})
+
+	Context("when the wallet is empty", func() {
+		It("should report no identities found", func() {
+			Expect(err).To(BeNil())
+			Expect(fmt.Sprint(out)).To(Equal("no identities found\n"))
+		})
+	})
+
+	Context("when the wallet has identities", func() {
+		BeforeEach(func() {
+			store := environment.NewWalletStore(environment.Home(home))
+			Expect(store.Put("admin", &environment.X509Identity{MSPID: "Org1MSP"})).To(Succeed())
+			Expect(store.Put("user1", &environment.X509Identity{MSPID: "Org1MSP"})).To(Succeed())
+		})
+
+		It("should print each identity label", func() {
+			Expect(err).To(BeNil())
+			Expect(fmt.Sprint(out)).To(ContainSubstring("admin"))
+			Expect(fmt.Sprint(out)).To(ContainSubstring("user1"))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/common"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// RemoveCommand implements the "fabric wallet remove" command
+type RemoveCommand struct {
+	common.Command
+
+	Label string
+}
+
+// NewRemoveCommand creates a new "fabric wallet remove" command
+func NewRemoveCommand(settings *environment.Settings) *cobra.Command {
+	c := &RemoveCommand{}
+	c.Settings = settings
+
+	cmd := &cobra.Command{
+		Use:   "remove <label>",
+		Short: "Remove an identity from the wallet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				c.Label = args[0]
+			}
+
+			if err := c.Validate(); err != nil {
+				return err
+			}
+
+			return c.Run()
+		},
+	}
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+// Validate checks that the command's arguments are valid
+func (c *RemoveCommand) Validate() error {
+	if c.Label == "" {
+		return errors.New("identity label not specified")
+	}
+
+	return nil
+}
+
+// Run removes the identity stored under the given label
+func (c *RemoveCommand) Run() error {
+	store := environment.NewWalletStore(c.Settings.Home)
+
+	if err := store.Remove(c.Label); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.Out(), "identity '%s' removed\n", c.Label)
+
+	return nil
+}
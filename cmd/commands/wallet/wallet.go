@@ -0,0 +1,34 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package wallet implements the "fabric wallet" command tree, which manages
+// the identities used by the gateway commands.
+package wallet
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// NewWalletCommand creates a new "fabric wallet" command
+func NewWalletCommand(settings *environment.Settings) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet",
+		Short: "Manage gateway wallet identities",
+	}
+
+	cmd.AddCommand(
+		NewImportCommand(settings),
+		NewListCommand(settings),
+		NewExportCommand(settings),
+		NewRemoveCommand(settings),
+	)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
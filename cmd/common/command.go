@@ -0,0 +1,39 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package common provides the building blocks shared by every fabric-cli
+// command implementation.
+package common
+
+import (
+	"context"
+	"io"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// Command is embedded by every command implementation. It carries the
+// environment settings needed to produce output and resolve the current
+// context.
+type Command struct {
+	Settings *environment.Settings
+}
+
+// Out returns the writer that commands should print their output to
+func (c *Command) Out() io.Writer {
+	return c.Settings.Streams.Out
+}
+
+// Context returns the context.Context for this invocation. It is cancelled
+// when the process receives a shutdown signal, so long-lived commands can
+// use it to release connections promptly.
+func (c *Command) Context() context.Context {
+	if c.Settings != nil && c.Settings.Context != nil {
+		return c.Settings.Context
+	}
+
+	return context.Background()
+}
@@ -0,0 +1,83 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Command fabric is the fabric-cli entry point.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperledger/fabric-cli/cmd/commands/channel"
+	"github.com/hyperledger/fabric-cli/cmd/commands/gateway"
+	"github.com/hyperledger/fabric-cli/cmd/commands/lifecycle"
+	"github.com/hyperledger/fabric-cli/cmd/commands/wallet"
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A single process-level signal handler cancels the context that is
+	// threaded through every command, so that any open SDK/gateway
+	// connections are closed promptly instead of only on normal exit.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	settings := &environment.Settings{
+		Home: environment.Home(homeDir()),
+		Streams: environment.Streams{
+			In:  os.Stdin,
+			Out: os.Stdout,
+			Err: os.Stderr,
+		},
+		Context: ctx,
+	}
+
+	if err := newRootCommand(settings).Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func newRootCommand(settings *environment.Settings) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fabric",
+		Short: "A command line client for Hyperledger Fabric",
+	}
+
+	cmd.AddCommand(
+		channel.NewChannelCommand(settings),
+		lifecycle.NewLifecycleCommand(settings),
+		gateway.NewGatewayCommand(settings),
+		wallet.NewWalletCommand(settings),
+	)
+
+	cmd.SetOutput(settings.Streams.Out)
+
+	return cmd
+}
+
+func homeDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".fabric"
+	}
+
+	return filepath.Join(home, ".fabric")
+}
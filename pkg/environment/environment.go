@@ -0,0 +1,88 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package environment holds the runtime settings that are shared across all
+// fabric-cli commands: where the CLI's home directory lives, the persisted
+// connection configuration, and the streams used for command output.
+package environment
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+)
+
+// Streams holds the standard streams used by commands for input and output.
+type Streams struct {
+	In  io.Reader
+	Out io.Writer
+	Err io.Writer
+}
+
+// Home describes the location of the fabric-cli home directory, e.g. ~/.fabric
+type Home string
+
+// String returns the home directory as a string
+func (h Home) String() string {
+	return string(h)
+}
+
+// Path returns the path to a file rooted at the home directory
+func (h Home) Path(elem ...string) string {
+	return filepath.Join(append([]string{h.String()}, elem...)...)
+}
+
+// WalletDir returns the path to the wallet store
+func (h Home) WalletDir() string {
+	return h.Path("wallet")
+}
+
+// ConfigFile returns the path to the persisted CLI configuration
+func (h Home) ConfigFile() string {
+	return h.Path("config.yaml")
+}
+
+// Context represents a named connection context that associates a connection
+// profile, organization and user identity with an (optional) channel.
+type Context struct {
+	ConnectionConfig string `json:"connectionConfig,omitempty" yaml:"connectionConfig,omitempty"`
+	Organization     string `json:"organization,omitempty" yaml:"organization,omitempty"`
+	User             string `json:"user,omitempty" yaml:"user,omitempty"`
+	Channel          string `json:"channel,omitempty" yaml:"channel,omitempty"`
+}
+
+// Config is the persisted fabric-cli configuration, i.e. the set of contexts
+// known to the CLI and which one is currently selected.
+type Config struct {
+	Contexts       map[string]*Context `json:"contexts" yaml:"contexts"`
+	CurrentContext string              `json:"currentContext" yaml:"currentContext"`
+}
+
+// Current returns the currently selected context
+func (c *Config) Current() (*Context, error) {
+	if c == nil || c.CurrentContext == "" {
+		return nil, errNoCurrentContext
+	}
+
+	ctx, ok := c.Contexts[c.CurrentContext]
+	if !ok {
+		return nil, errNoCurrentContext
+	}
+
+	return ctx, nil
+}
+
+// Settings holds the runtime settings shared across commands.
+type Settings struct {
+	Home    Home
+	Streams Streams
+	Config  *Config
+
+	// Context is cancelled when the process receives a shutdown signal, so
+	// that commands can release SDK/gateway connections promptly instead of
+	// only on normal exit.
+	Context context.Context
+}
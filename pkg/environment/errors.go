@@ -0,0 +1,11 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package environment
+
+import "errors"
+
+var errNoCurrentContext = errors.New("no current context set")
@@ -0,0 +1,110 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package environment
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// X509Identity is a wallet identity backed by an X.509 certificate and
+// private key.
+type X509Identity struct {
+	MSPID       string `json:"mspId"`
+	Certificate string `json:"certificate"`
+	Key         string `json:"key"`
+}
+
+// WalletStore is a filesystem-backed store of wallet identities, rooted at
+// the CLI's home directory, used by the gateway commands to resolve the
+// identity a transaction should be submitted as.
+type WalletStore struct {
+	dir string
+}
+
+// NewWalletStore creates a wallet store rooted at the given home directory.
+func NewWalletStore(home Home) *WalletStore {
+	return &WalletStore{dir: home.WalletDir()}
+}
+
+// Put persists an identity under the given label, overwriting any existing
+// identity with the same label.
+func (w *WalletStore) Put(label string, identity *X509Identity) error {
+	if err := os.MkdirAll(w.dir, 0700); err != nil {
+		return errors.WithMessage(err, "failed to create wallet directory")
+	}
+
+	b, err := json.MarshalIndent(identity, "", "  ")
+	if err != nil {
+		return errors.WithMessage(err, "failed to marshal identity")
+	}
+
+	if err := ioutil.WriteFile(w.path(label), b, 0600); err != nil {
+		return errors.WithMessage(err, "failed to write identity")
+	}
+
+	return nil
+}
+
+// Get retrieves the identity stored under the given label.
+func (w *WalletStore) Get(label string) (*X509Identity, error) {
+	b, err := ioutil.ReadFile(w.path(label))
+	if os.IsNotExist(err) {
+		return nil, errors.Errorf("identity '%s' not found in wallet", label)
+	} else if err != nil {
+		return nil, errors.WithMessage(err, "failed to read identity")
+	}
+
+	identity := &X509Identity{}
+	if err := json.Unmarshal(b, identity); err != nil {
+		return nil, errors.WithMessage(err, "failed to unmarshal identity")
+	}
+
+	return identity, nil
+}
+
+// List returns the labels of all identities currently in the wallet.
+func (w *WalletStore) List() ([]string, error) {
+	entries, err := ioutil.ReadDir(w.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.WithMessage(err, "failed to read wallet directory")
+	}
+
+	labels := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".id" {
+			labels = append(labels, strings.TrimSuffix(entry.Name(), ".id"))
+		}
+	}
+
+	return labels, nil
+}
+
+// Remove deletes the identity stored under the given label.
+func (w *WalletStore) Remove(label string) error {
+	if err := os.Remove(w.path(label)); err != nil {
+		if os.IsNotExist(err) {
+			return errors.Errorf("identity '%s' not found in wallet", label)
+		}
+
+		return errors.WithMessage(err, "failed to remove identity")
+	}
+
+	return nil
+}
+
+func (w *WalletStore) path(label string) string {
+	return filepath.Join(w.dir, label+".id")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package environment_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+var _ = Describe("WalletStore", func() {
+	var (
+		store *environment.WalletStore
+		home  string
+	)
+
+	BeforeEach(func() {
+		var err error
+		home, err = ioutil.TempDir("", "fabric-cli-wallet-store")
+		Expect(err).To(BeNil())
+
+		store = environment.NewWalletStore(environment.Home(home))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(home)
+	})
+
+	Describe("List", func() {
+		It("should return no labels when the wallet directory doesn't exist yet", func() {
+			labels, err := store.List()
+			Expect(err).To(BeNil())
+			Expect(labels).To(BeEmpty())
+		})
+	})
+
+	Describe("Get", func() {
+		It("should fail when the identity doesn't exist", func() {
+			_, err := store.Get("admin")
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not found in wallet"))
+		})
+	})
+
+	Describe("Put", func() {
+		It("should persist the identity so it can be retrieved", func() {
+			identity := &environment.X509Identity{
+				MSPID:       "Org1MSP",
+				Certificate: "CERT",
+				Key:         "KEY",
+			}
+
+			Expect(store.Put("admin", identity)).To(Succeed())
+
+			got, err := store.Get("admin")
+			Expect(err).To(BeNil())
+			Expect(got).To(Equal(identity))
+		})
+
+		It("should overwrite an existing identity with the same label", func() {
+			Expect(store.Put("admin", &environment.X509Identity{MSPID: "Org1MSP"})).To(Succeed())
+			Expect(store.Put("admin", &environment.X509Identity{MSPID: "Org2MSP"})).To(Succeed())
+
+			got, err := store.Get("admin")
+			Expect(err).To(BeNil())
+			Expect(got.MSPID).To(Equal("Org2MSP"))
+		})
+
+		It("should be reflected in List", func() {
+			Expect(store.Put("admin", &environment.X509Identity{MSPID: "Org1MSP"})).To(Succeed())
+			Expect(store.Put("user1", &environment.X509Identity{MSPID: "Org1MSP"})).To(Succeed())
+
+			labels, err := store.List()
+			Expect(err).To(BeNil())
+			Expect(labels).To(ConsistOf("admin", "user1"))
+		})
+	})
+
+	Describe("Remove", func() {
+		It("should fail when the identity doesn't exist", func() {
+			err := store.Remove("admin")
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not found in wallet"))
+		})
+
+		It("should delete a previously put identity", func() {
+			Expect(store.Put("admin", &environment.X509Identity{MSPID: "Org1MSP"})).To(Succeed())
+			Expect(store.Remove("admin")).To(Succeed())
+
+			_, err := store.Get("admin")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
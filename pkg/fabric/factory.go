@@ -0,0 +1,118 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package fabric wraps the fabric-sdk-go client surface that fabric-cli
+// commands depend on, so that the commands themselves can be written and
+// tested against small, mockable interfaces rather than the SDK directly.
+package fabric
+
+import (
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/resmgmt"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	sdkconfig "github.com/hyperledger/fabric-sdk-go/pkg/core/config"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	sdkgateway "github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+	"github.com/hyperledger/fabric-cli/pkg/fabric/gateway"
+)
+
+// Factory creates the fabric-sdk-go clients that commands operate on. A
+// single Factory is shared for the lifetime of a command invocation so that
+// the underlying SDK (and its connections) can be reused and cleanly closed.
+type Factory interface {
+	// SDK returns the underlying fabric-sdk-go SDK instance, creating it on
+	// first use.
+	SDK() (*fabsdk.FabricSDK, error)
+
+	// ResourceManagement returns a resource management client for the
+	// current context.
+	ResourceManagement() (ResourceManagement, error)
+
+	// Gateway returns a gateway connection for the given wallet identity,
+	// creating it on first use. The connection is cached once per Factory:
+	// later calls return it whatever identity they pass.
+	Gateway(identity *environment.X509Identity, options ...sdkgateway.Option) (*gateway.Gateway, error)
+}
+
+// NewFactory creates a new Factory backed by the given configuration.
+func NewFactory(cfg *environment.Config) (Factory, error) {
+	ctx, err := cfg.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	return &factory{config: cfg, context: ctx}, nil
+}
+
+type factory struct {
+	config  *environment.Config
+	context *environment.Context
+
+	sdk *fabsdk.FabricSDK
+	rm  ResourceManagement
+	gw  *gateway.Gateway
+}
+
+func (f *factory) SDK() (*fabsdk.FabricSDK, error) {
+	if f.sdk != nil {
+		return f.sdk, nil
+	}
+
+	sdk, err := fabsdk.New(sdkconfig.FromFile(f.context.ConnectionConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	f.sdk = sdk
+
+	return f.sdk, nil
+}
+
+func (f *factory) ResourceManagement() (ResourceManagement, error) {
+	if f.rm != nil {
+		return f.rm, nil
+	}
+
+	sdk, err := f.SDK()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := resmgmt.New(sdk.Context(fabsdk.WithUser(f.context.User), fabsdk.WithOrg(f.context.Organization)))
+	if err != nil {
+		return nil, err
+	}
+
+	f.rm = client
+
+	return f.rm, nil
+}
+
+func (f *factory) Gateway(identity *environment.X509Identity, options ...sdkgateway.Option) (*gateway.Gateway, error) {
+	if f.gw != nil {
+		return f.gw, nil
+	}
+
+	gw, err := gateway.Connect(f.context.ConnectionConfig, identity, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	f.gw = gw
+
+	return f.gw, nil
+}
+
+// ResourceManagement defines the chaincode and channel lifecycle operations
+// used by fabric-cli commands. It mirrors the subset of resmgmt.Client that
+// the CLI depends on, so it can be faked in command tests.
+type ResourceManagement interface {
+	InstantiateCC(channelID string, req resmgmt.InstantiateCCRequest, options ...resmgmt.RequestOption) (resmgmt.InstantiateCCResponse, error)
+	LifecycleApproveCC(channelID string, req resmgmt.LifecycleApproveCCRequest, options ...resmgmt.RequestOption) (fab.TransactionID, error)
+	LifecycleCommitCC(channelID string, req resmgmt.LifecycleCommitCCRequest, options ...resmgmt.RequestOption) (fab.TransactionID, error)
+	LifecycleCheckCCCommitReadiness(channelID string, req resmgmt.LifecycleCheckCCCommitReadinessRequest, options ...resmgmt.RequestOption) (resmgmt.LifecycleCheckCCCommitReadinessResponse, error)
+}
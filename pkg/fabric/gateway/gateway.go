@@ -0,0 +1,54 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package gateway wraps the fabric-sdk-go gateway programming model so that
+// fabric-cli commands can submit and evaluate transactions using a wallet
+// identity and connection profile, without a full resmgmt/channel client
+// setup.
+package gateway
+
+import (
+	sdkconfig "github.com/hyperledger/fabric-sdk-go/pkg/core/config"
+	"github.com/hyperledger/fabric-sdk-go/pkg/gateway"
+	"github.com/pkg/errors"
+
+	"github.com/hyperledger/fabric-cli/pkg/environment"
+)
+
+// Gateway wraps a fabric-sdk-go gateway connection for a single wallet
+// identity.
+type Gateway struct {
+	*gateway.Gateway
+}
+
+// Connect establishes a new Gateway connection using the given connection
+// profile and wallet identity. Discovery, endorsement and commit-listening
+// are handled by the underlying SDK.
+func Connect(connectionConfig string, identity *environment.X509Identity, options ...gateway.Option) (*Gateway, error) {
+	if identity == nil {
+		return nil, errors.New("no wallet identity provided")
+	}
+
+	wallet := gateway.NewInMemoryWallet()
+
+	const label = "identity"
+
+	if err := wallet.Put(label, gateway.NewX509Identity(identity.MSPID, identity.Certificate, identity.Key)); err != nil {
+		return nil, errors.WithMessage(err, "failed to load wallet identity")
+	}
+
+	opts := append([]gateway.Option{
+		gateway.WithConfig(sdkconfig.FromFile(connectionConfig)),
+		gateway.WithIdentity(wallet, label),
+	}, options...)
+
+	gw, err := gateway.Connect(opts...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to connect to gateway")
+	}
+
+	return &Gateway{Gateway: gw}, nil
+}